@@ -1,4 +1,7 @@
-use self::{attestation::AttestationCmd, elc::ELCCmd, enclave::EnclaveCmd, service::ServiceCmd};
+use self::{
+    attestation::AttestationCmd, elc::ELCCmd, enclave::EnclaveCmd, estimate::EstimateCost,
+    proof::ParseProof, service::ServiceCmd,
+};
 use crate::{enclave::build_enclave_loader, opts::Opts};
 use anyhow::Result;
 use clap::Parser;
@@ -9,6 +12,8 @@ use store::{host::HostStore, rocksdb::RocksDBStore};
 mod attestation;
 mod elc;
 mod enclave;
+mod estimate;
+mod proof;
 mod service;
 
 /// Cli Subcommands
@@ -23,6 +28,16 @@ pub enum CliCmd {
     ELC(ELCCmd),
     #[clap(subcommand, display_order = 4, about = "Service subcommands")]
     Service(ServiceCmd),
+    #[clap(
+        display_order = 5,
+        about = "Estimate the on-chain verification cost of a proof"
+    )]
+    EstimateCost(EstimateCost),
+    #[clap(
+        display_order = 6,
+        about = "Parse a commitment proof into human-readable JSON"
+    )]
+    ParseProof(ParseProof),
 }
 
 impl CliCmd {
@@ -45,6 +60,8 @@ impl CliCmd {
                 Self::setup_env(opts);
                 cmd.run(opts, build_enclave_loader::<RocksDBStore>())
             }
+            CliCmd::EstimateCost(cmd) => cmd.run(),
+            CliCmd::ParseProof(cmd) => cmd.run(),
         }
     }
 
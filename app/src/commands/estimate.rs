@@ -0,0 +1,85 @@
+use super::proof::read_proof_file;
+use anyhow::{anyhow, Result};
+use clap::Parser;
+use commitments::{CommitmentProof, EthABIEncoder};
+use serde_json::json;
+use std::{path::PathBuf, str::FromStr};
+
+/// Gas charged per zero byte of calldata (EIP-2028)
+const EVM_CALLDATA_ZERO_BYTE_GAS: u64 = 4;
+/// Gas charged per non-zero byte of calldata (EIP-2028)
+const EVM_CALLDATA_NON_ZERO_BYTE_GAS: u64 = 16;
+
+/// Destination chain type of a proof
+#[allow(clippy::upper_case_acronyms)]
+#[derive(Clone, Copy, Debug, PartialEq, Eq)]
+pub enum Target {
+    EVM,
+    Cosmos,
+}
+
+impl FromStr for Target {
+    type Err = anyhow::Error;
+
+    fn from_str(s: &str) -> Result<Self, Self::Err> {
+        match s.to_lowercase().as_str() {
+            "evm" => Ok(Self::EVM),
+            "cosmos" => Ok(Self::Cosmos),
+            _ => Err(anyhow!(
+                "Target '{}' is not supported. The following targets are available: [evm, cosmos]",
+                s
+            )),
+        }
+    }
+}
+
+// `estimate-cost` subcommand
+#[derive(Clone, Debug, Parser, PartialEq)]
+pub struct EstimateCost {
+    /// Path to the proof file
+    #[clap(
+        long = "proof",
+        help = "Path to a file that contains an ABI-encoded commitment proof (raw or hex)"
+    )]
+    pub proof: PathBuf,
+    /// Destination chain type
+    #[clap(long = "target", help = "Destination chain type: [evm, cosmos]")]
+    pub target: Target,
+    /// Gas per byte of the tx size on the cosmos destination
+    /// The default value is the default `TxSizeCostPerByte` parameter of the cosmos-sdk auth module.
+    #[clap(
+        long = "tx_size_cost_per_byte",
+        default_value = "10",
+        help = "Gas per byte of the tx size on the cosmos destination"
+    )]
+    pub tx_size_cost_per_byte: u64,
+}
+
+impl EstimateCost {
+    pub fn run(&self) -> Result<()> {
+        let bz = read_proof_file(&self.proof)?;
+        // ensure that the given bytes are a valid commitment proof
+        let _ = CommitmentProof::ethabi_decode(&bz)
+            .map_err(|e| anyhow!("failed to decode the commitment proof: {:?}", e))?;
+
+        let res = match self.target {
+            Target::EVM => {
+                let zero_bytes = bz.iter().filter(|b| **b == 0).count() as u64;
+                let non_zero_bytes = bz.len() as u64 - zero_bytes;
+                json! {{
+                    "target": "evm",
+                    "size": bz.len(),
+                    "calldata_gas": zero_bytes * EVM_CALLDATA_ZERO_BYTE_GAS
+                        + non_zero_bytes * EVM_CALLDATA_NON_ZERO_BYTE_GAS,
+                }}
+            }
+            Target::Cosmos => json! {{
+                "target": "cosmos",
+                "size": bz.len(),
+                "tx_size_gas": bz.len() as u64 * self.tx_size_cost_per_byte,
+            }},
+        };
+        println!("{}", res);
+        Ok(())
+    }
+}
@@ -0,0 +1,91 @@
+use anyhow::{anyhow, bail, Result};
+use clap::Parser;
+use commitments::{CommitmentProof, EthABIEncoder, ProxyMessage};
+use serde_json::{json, Value};
+use std::path::{Path, PathBuf};
+
+// `parse-proof` subcommand
+#[derive(Clone, Debug, Parser, PartialEq)]
+pub struct ParseProof {
+    /// Path to the proof file
+    #[clap(
+        long = "proof",
+        help = "Path to a file that contains an ABI-encoded commitment proof (raw or hex)"
+    )]
+    pub proof: PathBuf,
+}
+
+impl ParseProof {
+    pub fn run(&self) -> Result<()> {
+        let proof = CommitmentProof::ethabi_decode(&read_proof_file(&self.proof)?)
+            .map_err(|e| anyhow!("failed to decode the commitment proof: {:?}", e))?;
+        let message = proof
+            .message()
+            .map_err(|e| anyhow!("failed to decode the proxy message: {:?}", e))?;
+        println!(
+            "{}",
+            json! {{
+                "signer": proof.signer.to_hex_string(),
+                "signature": format!("0x{}", hex::encode(&proof.signature)),
+                "message": proxy_message_to_json(message),
+            }}
+        );
+        Ok(())
+    }
+}
+
+fn proxy_message_to_json(message: ProxyMessage) -> Value {
+    match message {
+        ProxyMessage::UpdateState(m) => json! {{
+            "type": "UpdateState",
+            "prev_height": m.prev_height.map(|h| h.to_string()),
+            "prev_state_id": m.prev_state_id.map(|id| id.to_string()),
+            "post_height": m.post_height.to_string(),
+            "post_state_id": m.post_state_id.to_string(),
+            "timestamp": m.timestamp.to_string(),
+            "context": m.context.to_string(),
+            "emitted_states": m.emitted_states.iter().map(|s| json! {{
+                "height": s.0.to_string(),
+                "type_url": s.1.type_url,
+                "value": format!("0x{}", hex::encode(&s.1.value)),
+            }}).collect::<Vec<_>>(),
+        }},
+        ProxyMessage::VerifyMembership(m) => json! {{
+            "type": "VerifyMembership",
+            "prefix": String::from_utf8(m.prefix.clone())
+                .unwrap_or_else(|_| format!("0x{}", hex::encode(&m.prefix))),
+            "path": m.path,
+            "value": m.value.map(|v| format!("0x{}", hex::encode(v))),
+            "height": m.height.to_string(),
+            "state_id": m.state_id.to_string(),
+        }},
+        ProxyMessage::Misbehaviour(m) => json! {{
+            "type": "Misbehaviour",
+            "prev_states": m.prev_states.iter().map(|s| json! {{
+                "height": s.height.to_string(),
+                "state_id": s.state_id.to_string(),
+            }}).collect::<Vec<_>>(),
+            "context": m.context.to_string(),
+            "client_message": {
+                "type_url": m.client_message.type_url,
+                "value": format!("0x{}", hex::encode(&m.client_message.value)),
+            },
+        }},
+    }
+}
+
+/// Read a proof from the file
+/// The file content can be either raw bytes or a hex string with or without `0x` prefix.
+pub(crate) fn read_proof_file(path: &Path) -> Result<Vec<u8>> {
+    let bz = std::fs::read(path)?;
+    if bz.is_empty() {
+        bail!("proof file is empty: {:?}", path);
+    }
+    if let Ok(s) = std::str::from_utf8(&bz) {
+        let s = s.trim();
+        if let Ok(decoded) = hex::decode(s.strip_prefix("0x").unwrap_or(s)) {
+            return Ok(decoded);
+        }
+    }
+    Ok(bz)
+}